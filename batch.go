@@ -0,0 +1,59 @@
+package spi
+
+import "context"
+
+// BatchExecer is implemented by a Conn that supports batching multiple
+// statements into a single round-trip. Callers type-assert Conn to
+// BatchExecer to use it.
+//
+//	if be, ok := conn.(spi.BatchExecer); ok {
+//		batch := be.NewBatch(spi.BatchUnlogged)
+//		batch.Query("insert into my_table values(?, ?)", name, value)
+//		result := be.ExecBatch(ctx, batch)
+//	}
+type BatchExecer interface {
+	// NewBatch creates an empty Batch of the given kind.
+	NewBatch(kind BatchKind) Batch
+
+	// ExecBatch sends all statements queued in batch to the server in a
+	// single round-trip.
+	ExecBatch(ctx context.Context, batch Batch) BatchResult
+}
+
+// BatchKind selects how the statements of a Batch are applied on the server.
+type BatchKind int
+
+const (
+	// BatchLogged applies all statements of the batch atomically.
+	BatchLogged BatchKind = iota
+	// BatchUnlogged applies statements of the batch without the overhead of atomicity.
+	BatchUnlogged
+	// BatchCounter is a batch made up solely of counter-style updates.
+	BatchCounter
+)
+
+// Batch groups multiple statements so they can be sent to the server in a
+// single round-trip via BatchExecer.ExecBatch. Use BatchExecer.NewBatch to
+// create one.
+type Batch interface {
+	// Query adds a statement and its parameters to the batch.
+	Query(sql string, params ...any)
+
+	// Len returns the number of statements currently queued in the batch.
+	Len() int
+
+	// Reset empties the batch so it can be reused.
+	Reset()
+}
+
+// BatchResult is the outcome of executing a Batch via BatchExecer.ExecBatch.
+type BatchResult interface {
+	// Err returns the first error encountered while executing the batch, if any.
+	Err() error
+
+	// RowsAffected returns the sum of RowsAffected across all statements in the batch.
+	RowsAffected() int64
+
+	// Results returns the per-statement Result in the order they were queued.
+	Results() []Result
+}
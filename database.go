@@ -146,6 +146,37 @@ type Runtime struct {
 	MemHeapInUse   uint64
 	MemStackSys    uint64
 	MemStackInUse  uint64
+
+	// CPUUsagePercent and the fields below are host-level telemetry, only
+	// populated by a ServerInfoOptsGetter called with WithHostTelemetry.
+	CPUUsagePercent float64
+	LoadAvg1        float64
+	LoadAvg5        float64
+	LoadAvg15       float64
+	DiskUsage       []DiskStat
+	NetIO           []NetStat
+	OpenFDs         int32
+
+	// Pool reports telemetry of the DatabasePool in use, if any.
+	Pool *PoolStats
+}
+
+// DiskStat reports usage of a single mounted filesystem.
+type DiskStat struct {
+	Path       string
+	Total      uint64
+	Used       uint64
+	Free       uint64
+	InodesUsed uint64
+}
+
+// NetStat reports cumulative traffic counters for a single network interface.
+type NetStat struct {
+	Iface       string
+	BytesSent   uint64
+	BytesRecv   uint64
+	PacketsSent uint64
+	PacketsRecv uint64
 }
 
 type ServicePort struct {
@@ -4,3 +4,8 @@ import "errors"
 
 var ErrUserCancel error = errors.New("user cancel")
 var ErrNotImplemented = errors.New("not implemented")
+
+// Sentinels for transient errors that a RetryPolicy may dispatch on.
+var ErrServerBusy = errors.New("server busy")
+var ErrConnClosed = errors.New("connection closed")
+var ErrQueryTimeout = errors.New("query timeout")
@@ -0,0 +1,47 @@
+package spi
+
+import "context"
+
+// PagedQueryer is implemented by a Conn that supports paged iteration.
+// Callers type-assert Conn to PagedQueryer to use it.
+//
+//	if pq, ok := conn.(spi.PagedQueryer); ok {
+//		rows, _ := pq.QueryPaged(ctx, "select * from my_table", nil)
+//		defer rows.Close()
+//	}
+type PagedQueryer interface {
+	// QueryPaged executes a SQL statement like Conn.Query, but returns a
+	// PagedRows whose page size can be set and whose position can be
+	// resumed via WithPageState.
+	QueryPaged(ctx context.Context, sqlText string, opts []QueryOption, params ...any) (PagedRows, error)
+}
+
+// PagedRows is a Rows that fetches its records from the server one page at
+// a time, with the page size and resume position controlled by the caller.
+type PagedRows interface {
+	Rows
+
+	// PageSize sets how many records the server delivers per fetch. It must
+	// be called before the first call to Next.
+	PageSize(n int)
+
+	// PageState returns an opaque token describing the boundary of the last
+	// delivered page. Pass it to WithPageState on a later QueryPaged call to
+	// resume iteration from that point.
+	PageState() []byte
+}
+
+// QueryOption configures a PagedQueryer.QueryPaged call.
+type QueryOption interface {
+	queryoption()
+}
+
+func (o pageStateOption) queryoption() {}
+
+type pageStateOption []byte
+
+// WithPageState resumes a QueryPaged call at the boundary of the page
+// described by the given PageState() token.
+func WithPageState(state []byte) QueryOption {
+	return pageStateOption(state)
+}
@@ -0,0 +1,72 @@
+package spi
+
+import (
+	"context"
+	"time"
+)
+
+// DatabasePool represents a pool of Conn that can be acquired and released
+// by callers instead of opening a new connection for every unit of work.
+type DatabasePool interface {
+	// Acquire returns a PooledConn from the pool, opening a new underlying
+	// Conn if needed and the pool has not reached MaxConns.
+	Acquire(ctx context.Context) (PooledConn, error)
+
+	// Release returns a PooledConn to the pool for reuse.
+	Release(conn PooledConn)
+
+	// Stat returns a snapshot of the pool's current statistics.
+	Stat() PoolStats
+
+	// Close closes the pool and all connections it holds.
+	Close()
+}
+
+// PooledConn is a Conn that has been acquired from a DatabasePool.
+type PooledConn interface {
+	Conn
+
+	// Release returns this connection to the pool it was acquired from.
+	// It is equivalent to calling DatabasePool.Release(conn).
+	Release()
+}
+
+// PoolConfig configures the lifecycle of a DatabasePool.
+type PoolConfig struct {
+	// Connect opens a new underlying Conn, e.g. by wrapping Database.Connect
+	// with its ConnectOptions. Acquire calls it whenever the pool needs a new
+	// connection and has not reached MaxConns.
+	Connect func(ctx context.Context) (Conn, error)
+
+	// MinConns is the minimum number of connections the pool keeps open.
+	MinConns int
+	// MaxConns is the maximum number of connections the pool may open.
+	MaxConns int
+	// MaxConnLifetime is the maximum age of a connection before it is evicted.
+	MaxConnLifetime time.Duration
+	// MaxConnIdleTime is the maximum time a connection may sit idle before it is evicted.
+	MaxConnIdleTime time.Duration
+	// HealthCheckPeriod is how often idle connections are pinged via Pinger.
+	HealthCheckPeriod time.Duration
+	// AcquireTimeout bounds how long Acquire waits for a connection to become available.
+	AcquireTimeout time.Duration
+
+	// BeforeAcquire is invoked just before a connection is handed to a caller,
+	// so session state (time zone, precision, ...) can be reset. Returning
+	// false discards the connection instead of handing it out.
+	BeforeAcquire func(ctx context.Context, conn Conn) bool
+
+	// AfterRelease is invoked just after a caller releases a connection back
+	// to the pool, so it can be reset before it is reused. Returning false
+	// discards the connection instead of returning it to the pool.
+	AfterRelease func(conn Conn) bool
+}
+
+// PoolStats reports telemetry about a DatabasePool, for example so it can be
+// surfaced through DatabaseAux.GetServerInfo.
+type PoolStats struct {
+	AcquireCount    int64
+	AcquireDuration time.Duration
+	IdleConns       int32
+	TotalConns      int32
+}
@@ -0,0 +1,62 @@
+package spi
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy decides whether a failed operation should be retried, and if
+// so after how long. Conn.Exec, Conn.Query, Appender.Append and Pinger.Ping
+// consult the policy set via WithRetryPolicy on errors classified as
+// transient (ErrServerBusy, ErrConnClosed, ErrQueryTimeout, network
+// timeouts).
+type RetryPolicy interface {
+	// Attempt is called after the attemptN'th failure (starting at 1) with
+	// the error that occurred. It returns whether to retry and, if so, how
+	// long to wait before the next attempt.
+	Attempt(ctx context.Context, err error, attemptN int) (retry bool, delay time.Duration)
+}
+
+// WithRetryPolicy installs a RetryPolicy on the Conn produced by Connect.
+func WithRetryPolicy(policy RetryPolicy) ConnectOption {
+	return func(conn Conn) {
+		if setter, ok := conn.(retryPolicySetter); ok {
+			setter.SetRetryPolicy(policy)
+		}
+	}
+}
+
+// retryPolicySetter is implemented by Conn implementations that support
+// WithRetryPolicy.
+type retryPolicySetter interface {
+	SetRetryPolicy(policy RetryPolicy)
+}
+
+// SimpleRetryPolicy retries up to NumRetries times with no delay between
+// attempts.
+type SimpleRetryPolicy struct {
+	NumRetries int
+}
+
+func (p SimpleRetryPolicy) Attempt(ctx context.Context, err error, attemptN int) (bool, time.Duration) {
+	return attemptN <= p.NumRetries, 0
+}
+
+// ExponentialBackoffRetryPolicy retries up to NumRetries times, doubling the
+// delay between attempts starting at Min and capped at Max.
+type ExponentialBackoffRetryPolicy struct {
+	Min        time.Duration
+	Max        time.Duration
+	NumRetries int
+}
+
+func (p ExponentialBackoffRetryPolicy) Attempt(ctx context.Context, err error, attemptN int) (bool, time.Duration) {
+	if attemptN > p.NumRetries {
+		return false, 0
+	}
+	delay := p.Min << uint(attemptN-1)
+	if delay > p.Max || delay <= 0 {
+		delay = p.Max
+	}
+	return true, delay
+}
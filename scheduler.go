@@ -0,0 +1,74 @@
+package spi
+
+import (
+	"context"
+	"time"
+)
+
+// JobID identifies a job registered with a Scheduler.
+type JobID string
+
+// Scheduler runs recurring SQL tasks on a cron expression or a fixed
+// interval, such as periodic rollups or housekeeping queries.
+type Scheduler interface {
+	// Add registers spec and returns the JobID it was assigned.
+	Add(spec ScheduleSpec) (JobID, error)
+
+	// Remove unregisters the job with the given JobID.
+	Remove(id JobID) error
+
+	// List returns the status of every registered job.
+	List() []*JobStatus
+
+	// Start begins running registered jobs according to their schedule.
+	Start()
+
+	// Stop stops running jobs and waits for in-flight runs to finish.
+	Stop()
+}
+
+// ScheduleSpec describes a single scheduled job.
+type ScheduleSpec struct {
+	// Spec is a cron expression (e.g. "0 */5 * * * *"). Leave empty and set
+	// Interval instead to schedule by a fixed period.
+	Spec string
+	// Interval schedules the job to run on a fixed period instead of a cron
+	// expression. Ignored when Spec is set.
+	Interval time.Duration
+
+	// SqlText is executed by the Scheduler's own Conn when set.
+	SqlText string
+	// Func is invoked with a Conn when set, instead of SqlText. ctx is
+	// canceled when the Scheduler is stopped.
+	Func func(ctx context.Context, conn Conn) error
+
+	// Owner is the user the job runs as.
+	Owner string
+	// Renderer, if set, materializes the run's results to Output.
+	Renderer Renderer
+	// Output is where Renderer writes the materialized results.
+	Output OutputStream
+
+	// AllowConcurrent allows a new run to start while a previous run of the
+	// same job is still in-flight. By default overlapping executions are
+	// refused.
+	AllowConcurrent bool
+}
+
+// JobStatus reports the current state of a job registered with a Scheduler.
+type JobStatus struct {
+	Id           JobID
+	Spec         string
+	LastRunTime  time.Time
+	LastDuration time.Duration
+	LastErr      error
+	NextRunTime  time.Time
+}
+
+// SchedulerAux is implemented by a DatabaseAux backed by a Scheduler, so
+// dashboards can show running/failed schedules alongside GetInflights.
+// Callers type-assert DatabaseAux to SchedulerAux to use it.
+type SchedulerAux interface {
+	// GetScheduledJobs returns the status of every job registered with the Scheduler.
+	GetScheduledJobs() ([]*JobStatus, error)
+}
@@ -0,0 +1,27 @@
+package spi
+
+// ServerInfoOptsGetter is implemented by a DatabaseAux that can take
+// ServerInfoOption when building ServerInfo, such as WithHostTelemetry.
+// Callers type-assert DatabaseAux to ServerInfoOptsGetter to use it; plain
+// DatabaseAux.GetServerInfo is unaffected and keeps working for
+// implementers that don't support the extra options.
+type ServerInfoOptsGetter interface {
+	GetServerInfoOpts(opts ...ServerInfoOption) (*ServerInfo, error)
+}
+
+// ServerInfoOption configures a ServerInfoOptsGetter.GetServerInfoOpts call.
+type ServerInfoOption interface {
+	serverinfooption()
+}
+
+func (o hostTelemetryOption) serverinfooption() {}
+
+type hostTelemetryOption bool
+
+// WithHostTelemetry opts into populating the host-level telemetry fields of
+// Runtime (CPUUsagePercent, LoadAvg1/5/15, DiskUsage, NetIO, OpenFDs), which
+// are otherwise left zero since enumerating disks and network interfaces is
+// expensive.
+func WithHostTelemetry(enabled bool) ServerInfoOption {
+	return hostTelemetryOption(enabled)
+}
@@ -0,0 +1,62 @@
+package spi
+
+import "context"
+
+// Preparer is implemented by a Conn that supports preparing a statement
+// ahead of execution. Callers type-assert Conn to Preparer to use it.
+//
+//	if p, ok := conn.(spi.Preparer); ok {
+//		stmt, _ := p.Prepare(ctx, "select * from my_table where name = ?")
+//		defer stmt.Close()
+//	}
+type Preparer interface {
+	// Prepare parses sqlText on the server and returns a reusable Stmt.
+	// Repeated execution of the same Stmt avoids re-parsing and re-planning
+	// the statement on every call.
+	Prepare(ctx context.Context, sqlText string) (Stmt, error)
+}
+
+// Stmt is a statement that has been parsed and planned on the server ahead
+// of execution, obtained via Preparer.Prepare. Stmt must be closed once it
+// is no longer needed to release the server-side plan.
+type Stmt interface {
+	// Query executes the prepared statement expecting multiple rows as result.
+	//
+	// Rows returned by Query() must be closed to prevent server-side-resource leaks.
+	Query(ctx context.Context, params ...any) (Rows, error)
+
+	// QueryRow executes the prepared statement expecting a single row result.
+	QueryRow(ctx context.Context, params ...any) Row
+
+	// Exec executes the prepared statement that does not return result.
+	Exec(ctx context.Context, params ...any) Result
+
+	// ParamTypes returns the column-buffer type (see ColumnBufferTypeXXX) of
+	// each bind parameter, in order.
+	ParamTypes() []string
+
+	// Columns returns the result columns of the statement, applicable only
+	// when the statement is a SELECT.
+	Columns() (Columns, error)
+
+	// Close releases the server-side plan held by this Stmt.
+	Close() error
+}
+
+// WithStatementCacheSize sets the size of the per-connection LRU cache of
+// prepared statements keyed by SQL text, so repeated Conn.Exec/Conn.Query
+// calls with identical SQL automatically reuse a server-side plan. A size of
+// 0 disables the cache.
+func WithStatementCacheSize(n int) ConnectOption {
+	return func(conn Conn) {
+		if setter, ok := conn.(statementCacheSizeSetter); ok {
+			setter.SetStatementCacheSize(n)
+		}
+	}
+}
+
+// statementCacheSizeSetter is implemented by Conn implementations that
+// support WithStatementCacheSize.
+type statementCacheSizeSetter interface {
+	SetStatementCacheSize(n int)
+}